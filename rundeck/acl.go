@@ -0,0 +1,86 @@
+package rundeck
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+type SystemACLPolicy struct {
+	Name     string
+	Contents string
+}
+
+type ProjectACLPolicy struct {
+	Project  string
+	Name     string
+	Contents string
+}
+
+type aclResource struct {
+	Name string `xml:"name,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type aclResourceList struct {
+	XMLName   xml.Name      `xml:"resources"`
+	Resources []aclResource `xml:"contents>resource"`
+}
+
+func aclPolicyNames(resources []aclResource) []string {
+	names := make([]string, 0, len(resources))
+	for _, r := range resources {
+		if r.Type != "file" {
+			continue
+		}
+		names = append(names, strings.TrimSuffix(r.Name, ".aclpolicy"))
+	}
+	return names
+}
+
+func (c *Client) ListSystemACLPolicies() ([]string, error) {
+	list := &aclResourceList{}
+	err := c.get([]string{"system", "acl"}, nil, list)
+	return aclPolicyNames(list.Resources), err
+}
+
+func (c *Client) GetSystemACLPolicy(name string) (*SystemACLPolicy, error) {
+	policy := &SystemACLPolicy{Name: name}
+	err := c.getYAML([]string{"system", "acl", name + ".aclpolicy"}, &policy.Contents)
+	return policy, err
+}
+
+func (c *Client) CreateSystemACLPolicy(p *SystemACLPolicy) error {
+	return c.postYAML([]string{"system", "acl", p.Name + ".aclpolicy"}, p.Contents, nil)
+}
+
+func (c *Client) UpdateSystemACLPolicy(p *SystemACLPolicy) error {
+	return c.putYAML([]string{"system", "acl", p.Name + ".aclpolicy"}, p.Contents, nil)
+}
+
+func (c *Client) DeleteSystemACLPolicy(name string) error {
+	return c.delete([]string{"system", "acl", name + ".aclpolicy"})
+}
+
+func (c *Client) ListProjectACLPolicies(project string) ([]string, error) {
+	list := &aclResourceList{}
+	err := c.get([]string{"project", project, "acl"}, nil, list)
+	return aclPolicyNames(list.Resources), err
+}
+
+func (c *Client) GetProjectACLPolicy(project, name string) (*ProjectACLPolicy, error) {
+	policy := &ProjectACLPolicy{Project: project, Name: name}
+	err := c.getYAML([]string{"project", project, "acl", name + ".aclpolicy"}, &policy.Contents)
+	return policy, err
+}
+
+func (c *Client) CreateProjectACLPolicy(p *ProjectACLPolicy) error {
+	return c.postYAML([]string{"project", p.Project, "acl", p.Name + ".aclpolicy"}, p.Contents, nil)
+}
+
+func (c *Client) UpdateProjectACLPolicy(p *ProjectACLPolicy) error {
+	return c.putYAML([]string{"project", p.Project, "acl", p.Name + ".aclpolicy"}, p.Contents, nil)
+}
+
+func (c *Client) DeleteProjectACLPolicy(project, name string) error {
+	return c.delete([]string{"project", project, "acl", name + ".aclpolicy"})
+}
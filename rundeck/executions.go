@@ -0,0 +1,122 @@
+package rundeck
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/url"
+)
+
+type Execution struct {
+	XMLName        xml.Name    `xml:"execution"`
+	ID             string      `xml:"id,attr"`
+	Status         string      `xml:"status,attr"`
+	Project        string      `xml:"project,attr"`
+	Job            *JobSummary `xml:"job,omitempty"`
+	DateStarted    string      `xml:"date-started"`
+	DateEnded      string      `xml:"date-ended"`
+	User           string      `xml:"user"`
+	FailedNodes    []string    `xml:"failedNodes>node"`
+	SucceededNodes []string    `xml:"succeededNodes>node"`
+}
+
+type executionList struct {
+	XMLName    xml.Name    `xml:"executions"`
+	Executions []Execution `xml:"execution"`
+}
+
+type RunJobOptions struct {
+	Options    map[string]string
+	NodeFilter string
+	AsUser     string
+	LogLevel   string
+}
+
+type ExecutionFilter struct {
+	Status string
+	Max    int
+	Offset int
+}
+
+type AbortResult struct {
+	XMLName   xml.Name  `xml:"abort"`
+	Status    string    `xml:"status,attr"`
+	Execution Execution `xml:"execution"`
+}
+
+type ExecutionOutputEntry struct {
+	Time    string `xml:"time,attr"`
+	Level   string `xml:"level,attr"`
+	Node    string `xml:"node,attr"`
+	Message string `xml:",chardata"`
+}
+
+type ExecutionOutput struct {
+	XMLName   xml.Name               `xml:"output"`
+	Offset    int                    `xml:"offset,attr"`
+	Completed bool                   `xml:"completed,attr"`
+	ExecState string                 `xml:"execState,attr"`
+	Entries   []ExecutionOutputEntry `xml:"entries>entry"`
+}
+
+func (c *Client) RunJob(uuid string, opts *RunJobOptions) (*Execution, error) {
+	// Rundeck's run-job API reads options from the request body, not the
+	// query string, so these are submitted as form params rather than
+	// attached to the endpoint URL.
+	form := url.Values{}
+	if opts != nil {
+		for k, v := range opts.Options {
+			form.Set("option."+k, v)
+		}
+		if opts.NodeFilter != "" {
+			form.Set("filter", opts.NodeFilter)
+		}
+		if opts.AsUser != "" {
+			form.Set("asUser", opts.AsUser)
+		}
+		if opts.LogLevel != "" {
+			form.Set("loglevel", opts.LogLevel)
+		}
+	}
+
+	execution := &Execution{}
+	err := c.postForm([]string{"job", uuid, "executions"}, form, execution)
+	return execution, err
+}
+
+func (c *Client) GetExecution(id string) (*Execution, error) {
+	execution := &Execution{}
+	err := c.get([]string{"execution", id}, nil, execution)
+	return execution, err
+}
+
+func (c *Client) AbortExecution(id string) (*AbortResult, error) {
+	result := &AbortResult{}
+	err := c.post([]string{"execution", id, "abort"}, nil, result)
+	return result, err
+}
+
+func (c *Client) GetExecutionsForJob(jobID string, filter *ExecutionFilter) ([]Execution, error) {
+	params := url.Values{}
+	if filter != nil {
+		if filter.Status != "" {
+			params.Set("status", filter.Status)
+		}
+		if filter.Max > 0 {
+			params.Set("max", fmt.Sprintf("%d", filter.Max))
+		}
+		if filter.Offset > 0 {
+			params.Set("offset", fmt.Sprintf("%d", filter.Offset))
+		}
+	}
+
+	executions := &executionList{}
+	err := c.get([]string{"job", jobID, "executions"}, params, executions)
+	return executions.Executions, err
+}
+
+func (c *Client) GetExecutionOutput(id string, offset int) (*ExecutionOutput, error) {
+	params := url.Values{"offset": []string{fmt.Sprintf("%d", offset)}}
+	output := &ExecutionOutput{}
+	err := c.get([]string{"execution", id, "output"}, params, output)
+	return output, err
+}
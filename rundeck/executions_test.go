@@ -0,0 +1,60 @@
+package rundeck
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestExecution_Unmarshal(t *testing.T) {
+	data := []byte(`
+		<execution id="123" status="succeeded" project="demo">
+			<user>alice</user>
+			<date-started>2020-01-01T00:00:00Z</date-started>
+			<date-ended>2020-01-01T00:01:00Z</date-ended>
+			<succeededNodes>
+				<node>node1</node>
+				<node>node2</node>
+			</succeededNodes>
+			<failedNodes>
+				<node>node3</node>
+			</failedNodes>
+		</execution>
+	`)
+
+	got := &Execution{}
+	if err := xml.Unmarshal(data, got); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+
+	if got.ID != "123" || got.Status != "succeeded" || got.Project != "demo" {
+		t.Errorf("got %+v, want id=123 status=succeeded project=demo", got)
+	}
+	if got.User != "alice" {
+		t.Errorf("got user %q, want alice", got.User)
+	}
+	if len(got.SucceededNodes) != 2 || len(got.FailedNodes) != 1 {
+		t.Errorf("got succeeded=%v failed=%v, want 2 succeeded and 1 failed", got.SucceededNodes, got.FailedNodes)
+	}
+}
+
+func TestExecutionOutput_Unmarshal(t *testing.T) {
+	data := []byte(`
+		<output offset="42" completed="true" execState="succeeded">
+			<entries>
+				<entry time="00:00:01" level="NORMAL" node="node1">hello</entry>
+			</entries>
+		</output>
+	`)
+
+	got := &ExecutionOutput{}
+	if err := xml.Unmarshal(data, got); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+
+	if got.Offset != 42 || !got.Completed || got.ExecState != "succeeded" {
+		t.Errorf("got %+v, want offset=42 completed=true execState=succeeded", got)
+	}
+	if len(got.Entries) != 1 || got.Entries[0].Message != "hello" {
+		t.Errorf("got entries %+v, want one entry with message=hello", got.Entries)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/xml"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -37,6 +38,8 @@ type JobDetail struct {
 	RankOrder                 string             `xml:"dispatch>rankOrder"`
 	CommandSequence           JobCommandSequence `xml:"sequence"`
 	NodeFilter                JobNodeFilter      `xml:"nodefilters"`
+	Schedule                  *JobSchedule       `xml:"schedule,omitempty"`
+	Notifications             *JobNotifications  `xml:"notification,omitempty"`
 }
 
 type jobDetailList struct {
@@ -75,13 +78,26 @@ type JobCommandSequence struct {
 
 type JobCommand struct {
 	XMLName        xml.Name
-	ShellCommand   string            `xml:"exec"`
-	Script         string            `xml:"script"`
-	ScriptFile     string            `xml:"scriptfile"`
-	ScriptFileArgs string            `xml:"scriptargs"`
-	Job            *JobCommandJobRef `xml:"jobref"`
-	StepPlugin     *JobPlugin        `xml:"step-plugin"`
-	NodeStepPlugin *JobPlugin        `xml:"node-step-plugin"`
+	ShellCommand   string                  `xml:"exec"`
+	Script         string                  `xml:"script"`
+	ScriptFile     string                  `xml:"scriptfile"`
+	ScriptFileArgs string                  `xml:"scriptargs"`
+	Job            *JobCommandJobRef       `xml:"jobref"`
+	StepPlugin     *JobPlugin              `xml:"step-plugin"`
+	NodeStepPlugin *JobPlugin              `xml:"node-step-plugin"`
+	ErrorHandler   *JobCommandErrorHandler `xml:"errorhandler,omitempty"`
+}
+
+type JobCommandErrorHandler struct {
+	XMLName            xml.Name          `xml:"errorhandler"`
+	KeepGoingOnSuccess bool              `xml:"keepgoingOnSuccess,attr"`
+	ShellCommand       string            `xml:"exec"`
+	Script             string            `xml:"script"`
+	ScriptFile         string            `xml:"scriptfile"`
+	ScriptFileArgs     string            `xml:"scriptargs"`
+	Job                *JobCommandJobRef `xml:"jobref"`
+	StepPlugin         *JobPlugin        `xml:"step-plugin"`
+	NodeStepPlugin     *JobPlugin        `xml:"node-step-plugin"`
 }
 
 type JobCommandJobRef struct {
@@ -107,6 +123,59 @@ type JobNodeFilter struct {
 	Query             string `xml:"filter"`
 }
 
+type JobSchedule struct {
+	XMLName xml.Name            `xml:"schedule"`
+	Time    JobScheduleTime     `xml:"time"`
+	Month   JobScheduleMonth    `xml:"month"`
+	WeekDay *JobScheduleWeekDay `xml:"weekday,omitempty"`
+	Year    JobScheduleYear     `xml:"year"`
+}
+
+type JobScheduleTime struct {
+	Seconds string `xml:"seconds,attr"`
+	Minute  string `xml:"minute,attr"`
+	Hour    string `xml:"hour,attr"`
+}
+
+type JobScheduleMonth struct {
+	Day   string `xml:"day,attr"`
+	Month string `xml:"month,attr"`
+}
+
+type JobScheduleWeekDay struct {
+	Day string `xml:"day,attr"`
+}
+
+type JobScheduleYear struct {
+	Year string `xml:"year,attr"`
+}
+
+type JobNotifications struct {
+	OnSuccess *JobNotification `xml:"onsuccess,omitempty"`
+	OnFailure *JobNotification `xml:"onfailure,omitempty"`
+	OnStart   *JobNotification `xml:"onstart,omitempty"`
+}
+
+type JobNotification struct {
+	Email   *EmailNotification   `xml:"email,omitempty"`
+	WebHook *WebHookNotification `xml:"webhook,omitempty"`
+	Plugins []JobPlugin          `xml:"plugin,omitempty"`
+}
+
+type EmailNotification struct {
+	Recipients string `xml:"recipients,attr"`
+	Subject    string `xml:"subject,attr,omitempty"`
+	AttachLog  bool   `xml:"attach,attr,omitempty"`
+}
+
+type WebHookNotification struct {
+	URLs       WebHookURLs `xml:"urls,attr"`
+	HTTPMethod string      `xml:"httpMethod,attr,omitempty"`
+	Format     string      `xml:"format,attr,omitempty"`
+}
+
+type WebHookURLs []string
+
 func (c *Client) GetJobsForProject(projectName string) ([]JobSummary, error) {
 	jobList := &jobSummaryList{}
 	err := c.get([]string{"project", projectName, "jobs"}, nil, jobList)
@@ -122,6 +191,42 @@ func (c *Client) GetJob(uuid string) (*JobDetail, error) {
 	return &jobList.Jobs[0], nil
 }
 
+// NewJobScheduleFromCrontab parses a 7-field cron-style string of the form
+// "sec min hour dom month dow year" into a JobSchedule.
+func NewJobScheduleFromCrontab(crontab string) (*JobSchedule, error) {
+	fields := strings.Fields(crontab)
+	if len(fields) != 7 {
+		return nil, fmt.Errorf("crontab %q must have 7 fields: sec min hour dom month dow year", crontab)
+	}
+
+	schedule := &JobSchedule{
+		Time:  JobScheduleTime{Seconds: fields[0], Minute: fields[1], Hour: fields[2]},
+		Month: JobScheduleMonth{Day: fields[3], Month: fields[4]},
+		Year:  JobScheduleYear{Year: fields[6]},
+	}
+	if dow := fields[5]; dow != "?" {
+		schedule.WeekDay = &JobScheduleWeekDay{Day: dow}
+	}
+	return schedule, nil
+}
+
+// Crontab renders the schedule back into its 7-field cron-style string.
+func (s *JobSchedule) Crontab() string {
+	dow := "?"
+	if s.WeekDay != nil {
+		dow = s.WeekDay.Day
+	}
+	return strings.Join([]string{
+		s.Time.Seconds,
+		s.Time.Minute,
+		s.Time.Hour,
+		s.Month.Day,
+		s.Month.Month,
+		dow,
+		s.Year.Year,
+	}, " ")
+}
+
 func (c JobValueChoices) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
 	if len(c) > 0 {
 		return xml.Attr{name, strings.Join(c, ",")}, nil
@@ -136,6 +241,19 @@ func (c *JobValueChoices) UnmarshalXMLAttr(attr xml.Attr) error {
 	return nil
 }
 
+func (u WebHookURLs) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if len(u) > 0 {
+		return xml.Attr{name, strings.Join(u, ",")}, nil
+	} else {
+		return xml.Attr{}, nil
+	}
+}
+
+func (u *WebHookURLs) UnmarshalXMLAttr(attr xml.Attr) error {
+	*u = strings.Split(attr.Value, ",")
+	return nil
+}
+
 func (a JobCommandJobRefArguments) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	start.Attr = []xml.Attr{
 		xml.Attr{xml.Name{Local: "line"}, string(a)},
@@ -229,4 +347,111 @@ func (c *JobPluginConfig) UnmarshalXML(d *xml.Decoder, start xml.StartElement) e
 			}
 		}
 	}
+}
+
+// MarshalXML emits a single step sub-element, the first populated one of
+// jobref, step-plugin, node-step-plugin, script and scriptfile (followed by
+// scriptargs when set), and exec otherwise.
+func (h *JobCommandErrorHandler) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{Local: "errorhandler"}
+	start.Attr = []xml.Attr{
+		{Name: xml.Name{Local: "keepgoingOnSuccess"}, Value: strconv.FormatBool(h.KeepGoingOnSuccess)},
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	switch {
+	case h.Job != nil:
+		if err := e.Encode(h.Job); err != nil {
+			return err
+		}
+	case h.StepPlugin != nil:
+		if err := e.EncodeElement(h.StepPlugin, xml.StartElement{Name: xml.Name{Local: "step-plugin"}}); err != nil {
+			return err
+		}
+	case h.NodeStepPlugin != nil:
+		if err := e.EncodeElement(h.NodeStepPlugin, xml.StartElement{Name: xml.Name{Local: "node-step-plugin"}}); err != nil {
+			return err
+		}
+	case h.Script != "":
+		if err := e.EncodeElement(h.Script, xml.StartElement{Name: xml.Name{Local: "script"}}); err != nil {
+			return err
+		}
+	case h.ScriptFile != "":
+		if err := e.EncodeElement(h.ScriptFile, xml.StartElement{Name: xml.Name{Local: "scriptfile"}}); err != nil {
+			return err
+		}
+		if h.ScriptFileArgs != "" {
+			if err := e.EncodeElement(h.ScriptFileArgs, xml.StartElement{Name: xml.Name{Local: "scriptargs"}}); err != nil {
+				return err
+			}
+		}
+	default:
+		if err := e.EncodeElement(h.ShellCommand, xml.StartElement{Name: xml.Name{Local: "exec"}}); err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(xml.EndElement{Name: start.Name})
+}
+
+func (h *JobCommandErrorHandler) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "keepgoingOnSuccess" {
+			h.KeepGoingOnSuccess, _ = strconv.ParseBool(attr.Value)
+		}
+	}
+
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "exec":
+				if err := d.DecodeElement(&h.ShellCommand, &t); err != nil {
+					return err
+				}
+			case "script":
+				if err := d.DecodeElement(&h.Script, &t); err != nil {
+					return err
+				}
+			case "scriptfile":
+				if err := d.DecodeElement(&h.ScriptFile, &t); err != nil {
+					return err
+				}
+			case "scriptargs":
+				if err := d.DecodeElement(&h.ScriptFileArgs, &t); err != nil {
+					return err
+				}
+			case "jobref":
+				h.Job = &JobCommandJobRef{}
+				if err := d.DecodeElement(h.Job, &t); err != nil {
+					return err
+				}
+			case "step-plugin":
+				h.StepPlugin = &JobPlugin{}
+				if err := d.DecodeElement(h.StepPlugin, &t); err != nil {
+					return err
+				}
+			case "node-step-plugin":
+				h.NodeStepPlugin = &JobPlugin{}
+				if err := d.DecodeElement(h.NodeStepPlugin, &t); err != nil {
+					return err
+				}
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			if t.Name.Local == start.Name.Local {
+				return nil
+			}
+		}
+	}
 }
\ No newline at end of file
@@ -0,0 +1,190 @@
+package rundeck
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestJobNotifications_EmailRoundTrip(t *testing.T) {
+	want := &JobNotifications{
+		OnSuccess: &JobNotification{
+			Email: &EmailNotification{
+				Recipients: "ops@example.com",
+				Subject:    "job succeeded",
+				AttachLog:  true,
+			},
+		},
+	}
+
+	data, err := xml.Marshal(want)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+
+	got := &JobNotifications{}
+	if err := xml.Unmarshal(data, got); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+
+	if got.OnSuccess == nil || got.OnSuccess.Email == nil {
+		t.Fatalf("expected OnSuccess.Email to round-trip, got %+v", got)
+	}
+	if *got.OnSuccess.Email != *want.OnSuccess.Email {
+		t.Errorf("got %+v, want %+v", got.OnSuccess.Email, want.OnSuccess.Email)
+	}
+}
+
+func TestJobNotifications_WebHookRoundTrip(t *testing.T) {
+	want := &JobNotifications{
+		OnFailure: &JobNotification{
+			WebHook: &WebHookNotification{
+				URLs:       WebHookURLs{"https://example.com/a", "https://example.com/b"},
+				HTTPMethod: "POST",
+				Format:     "json",
+			},
+		},
+	}
+
+	data, err := xml.Marshal(want)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+
+	got := &JobNotifications{}
+	if err := xml.Unmarshal(data, got); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+
+	if got.OnFailure == nil || got.OnFailure.WebHook == nil {
+		t.Fatalf("expected OnFailure.WebHook to round-trip, got %+v", got)
+	}
+	if len(got.OnFailure.WebHook.URLs) != 2 || got.OnFailure.WebHook.URLs[1] != "https://example.com/b" {
+		t.Errorf("got URLs %v, want %v", got.OnFailure.WebHook.URLs, want.OnFailure.WebHook.URLs)
+	}
+}
+
+func TestJobNotifications_PluginRoundTrip(t *testing.T) {
+	want := &JobNotifications{
+		OnStart: &JobNotification{
+			Plugins: []JobPlugin{
+				{
+					XMLName: xml.Name{Local: "plugin"},
+					Type:    "slack-notification",
+					Config:  JobPluginConfig{"channel": "#deploys"},
+				},
+			},
+		},
+	}
+
+	data, err := xml.Marshal(want)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+
+	got := &JobNotifications{}
+	if err := xml.Unmarshal(data, got); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+
+	if got.OnStart == nil || len(got.OnStart.Plugins) != 1 {
+		t.Fatalf("expected OnStart.Plugins to round-trip, got %+v", got)
+	}
+	if got.OnStart.Plugins[0].Type != "slack-notification" {
+		t.Errorf("got type %q, want %q", got.OnStart.Plugins[0].Type, "slack-notification")
+	}
+	if got.OnStart.Plugins[0].Config["channel"] != "#deploys" {
+		t.Errorf("got config %v, want channel=#deploys", got.OnStart.Plugins[0].Config)
+	}
+}
+
+func TestJobCommandErrorHandler_ExecExclusivity(t *testing.T) {
+	want := &JobCommandErrorHandler{
+		KeepGoingOnSuccess: true,
+		ShellCommand:       "echo recovering",
+	}
+
+	data, err := xml.Marshal(want)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+
+	if got := string(data); got != `<errorhandler keepgoingOnSuccess="true"><exec>echo recovering</exec></errorhandler>` {
+		t.Fatalf("unexpected marshaled XML: %s", got)
+	}
+
+	got := &JobCommandErrorHandler{}
+	if err := xml.Unmarshal(data, got); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+	if *got != *want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestJobCommandErrorHandler_ScriptFileExclusivity(t *testing.T) {
+	want := &JobCommandErrorHandler{
+		ScriptFile:     "recover.sh",
+		ScriptFileArgs: "--force",
+	}
+
+	data, err := xml.Marshal(want)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+
+	if got := string(data); got != `<errorhandler keepgoingOnSuccess="false"><scriptfile>recover.sh</scriptfile><scriptargs>--force</scriptargs></errorhandler>` {
+		t.Fatalf("unexpected marshaled XML: %s", got)
+	}
+
+	got := &JobCommandErrorHandler{}
+	if err := xml.Unmarshal(data, got); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+	if *got != *want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestJobCommandErrorHandler_JobRefExclusivity(t *testing.T) {
+	want := &JobCommandErrorHandler{
+		Job: &JobCommandJobRef{
+			Name:      "cleanup",
+			GroupName: "ops",
+		},
+	}
+
+	data, err := xml.Marshal(want)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+
+	got := &JobCommandErrorHandler{}
+	if err := xml.Unmarshal(data, got); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+
+	if got.Job == nil || got.Job.Name != "cleanup" || got.Job.GroupName != "ops" {
+		t.Fatalf("expected Job to round-trip, got %+v", got.Job)
+	}
+	if got.Script != "" || got.ShellCommand != "" {
+		t.Errorf("expected sibling step fields to stay empty, got %+v", got)
+	}
+}
+
+func TestNewJobScheduleFromCrontab(t *testing.T) {
+	tests := []string{
+		"0 0 12 ? * MON *",
+		"0 0 12 * * * 2020",
+		"0 0 12 1 * ? *",
+	}
+
+	for _, crontab := range tests {
+		schedule, err := NewJobScheduleFromCrontab(crontab)
+		if err != nil {
+			t.Fatalf("NewJobScheduleFromCrontab(%q) returned error: %v", crontab, err)
+		}
+		if got := schedule.Crontab(); got != crontab {
+			t.Errorf("Crontab() round-trip = %q, want %q", got, crontab)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package rundeck
+
+type KeyType string
+
+const (
+	KeyTypePrivate  KeyType = "private"
+	KeyTypePublic   KeyType = "public"
+	KeyTypePassword KeyType = "password"
+)
+
+type KeyMeta struct {
+	Name     string    `json:"name"`
+	Path     string    `json:"path"`
+	Type     string    `json:"type"`
+	Meta     KeyEntry  `json:"meta,omitempty"`
+	Contents []KeyMeta `json:"contents,omitempty"`
+}
+
+type KeyEntry struct {
+	KeyType     string `json:"rundeck.key.type"`
+	ContentMask string `json:"Rundeck-content-mask"`
+	ContentType string `json:"Rundeck-content-type"`
+}
+
+func contentTypeForKeyType(keyType KeyType) string {
+	switch keyType {
+	case KeyTypePrivate:
+		return "application/octet-stream"
+	case KeyTypePublic:
+		return "application/pgp-keys"
+	case KeyTypePassword:
+		return "application/x-rundeck-data-password"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+func (c *Client) UploadKey(path string, keyType KeyType, content []byte) error {
+	return c.putRaw([]string{"storage", "keys", path}, contentTypeForKeyType(keyType), content, nil)
+}
+
+func (c *Client) GetKeyMeta(path string) (*KeyMeta, error) {
+	meta := &KeyMeta{}
+	err := c.getJSON([]string{"storage", "keys", path}, meta)
+	return meta, err
+}
+
+func (c *Client) ListKeys(path string) ([]KeyMeta, error) {
+	meta, err := c.GetKeyMeta(path)
+	if err != nil {
+		return nil, err
+	}
+	return meta.Contents, nil
+}
+
+func (c *Client) DeleteKey(path string) error {
+	return c.delete([]string{"storage", "keys", path})
+}
+
+func (c *Client) GetPublicKeyContent(path string) ([]byte, error) {
+	return c.getRaw([]string{"storage", "keys", path}, "application/pgp-keys")
+}
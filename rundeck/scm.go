@@ -0,0 +1,53 @@
+package rundeck
+
+type SCMConfig struct {
+	Project     string            `json:"project"`
+	Integration string            `json:"integration"`
+	Type        string            `json:"type"`
+	Config      map[string]string `json:"config"`
+}
+
+type SCMStatus struct {
+	Synched bool   `json:"synched"`
+	State   string `json:"state"`
+	Message string `json:"message"`
+}
+
+type SCMActionRequest struct {
+	Input map[string]string `json:"input"`
+}
+
+type SCMActionResult struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+func (c *Client) GetSCMConfig(project, integration string) (*SCMConfig, error) {
+	config := &SCMConfig{}
+	err := c.getJSON([]string{"project", project, "scm", integration, "config"}, config)
+	return config, err
+}
+
+func (c *Client) SetupSCMPlugin(project, integration, pluginType string, config map[string]string) error {
+	return c.postJSON([]string{"project", project, "scm", integration, "plugin", pluginType, "setup"}, config, nil)
+}
+
+func (c *Client) EnableSCMPlugin(project, integration, pluginType string) error {
+	return c.postJSON([]string{"project", project, "scm", integration, "plugin", pluginType, "enable"}, nil, nil)
+}
+
+func (c *Client) DisableSCMPlugin(project, integration, pluginType string) error {
+	return c.postJSON([]string{"project", project, "scm", integration, "plugin", pluginType, "disable"}, nil, nil)
+}
+
+func (c *Client) GetSCMStatus(project, integration string) (*SCMStatus, error) {
+	status := &SCMStatus{}
+	err := c.getJSON([]string{"project", project, "scm", integration, "status"}, status)
+	return status, err
+}
+
+func (c *Client) PerformSCMAction(project, integration, actionID string, req *SCMActionRequest) (*SCMActionResult, error) {
+	result := &SCMActionResult{}
+	err := c.postJSON([]string{"project", project, "scm", integration, "action", actionID}, req, result)
+	return result, err
+}
@@ -0,0 +1,168 @@
+package rundeck
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	contentTypeYAML = "application/yaml"
+	contentTypeJSON = "application/json"
+	contentTypeForm = "application/x-www-form-urlencoded"
+)
+
+func (c *Client) endpointURL(endpoint []string, params url.Values) string {
+	u := fmt.Sprintf("%s/api/%d/%s", strings.TrimRight(c.BaseURL, "/"), c.APIVersion, strings.Join(endpoint, "/"))
+	if len(params) > 0 {
+		u += "?" + params.Encode()
+	}
+	return u
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+// rawRequest issues an HTTP request against the Rundeck API and returns the
+// raw response body. It underlies the non-XML transport helpers (YAML,
+// JSON, and raw-content payloads) used by subsystems whose wire format
+// isn't XML.
+func (c *Client) rawRequest(method string, endpoint []string, params url.Values, contentType string, body []byte, accept string) ([]byte, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequest(method, c.endpointURL(endpoint, params), reader)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	req.Header.Set("X-Rundeck-Auth-Token", c.AuthToken)
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("rundeck: %s %s: %s: %s", method, req.URL, resp.Status, respBody)
+	}
+	return respBody, nil
+}
+
+func (c *Client) getYAML(endpoint []string, out *string) error {
+	body, err := c.rawRequest("GET", endpoint, nil, "", nil, contentTypeYAML)
+	if err != nil {
+		return err
+	}
+	*out = string(body)
+	return nil
+}
+
+func (c *Client) postYAML(endpoint []string, contents string, out *string) error {
+	body, err := c.rawRequest("POST", endpoint, nil, contentTypeYAML, []byte(contents), contentTypeYAML)
+	if err != nil {
+		return err
+	}
+	if out != nil {
+		*out = string(body)
+	}
+	return nil
+}
+
+func (c *Client) putYAML(endpoint []string, contents string, out *string) error {
+	body, err := c.rawRequest("PUT", endpoint, nil, contentTypeYAML, []byte(contents), contentTypeYAML)
+	if err != nil {
+		return err
+	}
+	if out != nil {
+		*out = string(body)
+	}
+	return nil
+}
+
+func (c *Client) getJSON(endpoint []string, out interface{}) error {
+	body, err := c.rawRequest("GET", endpoint, nil, "", nil, contentTypeJSON)
+	if err != nil {
+		return err
+	}
+	if out == nil || len(body) == 0 {
+		return nil
+	}
+	return json.Unmarshal(body, out)
+}
+
+func (c *Client) postJSON(endpoint []string, in interface{}, out interface{}) error {
+	var payload []byte
+	if in != nil {
+		var err error
+		payload, err = json.Marshal(in)
+		if err != nil {
+			return err
+		}
+	}
+
+	body, err := c.rawRequest("POST", endpoint, nil, contentTypeJSON, payload, contentTypeJSON)
+	if err != nil {
+		return err
+	}
+	if out == nil || len(body) == 0 {
+		return nil
+	}
+	return json.Unmarshal(body, out)
+}
+
+func (c *Client) putRaw(endpoint []string, contentType string, content []byte, out interface{}) error {
+	body, err := c.rawRequest("PUT", endpoint, nil, contentType, content, contentTypeJSON)
+	if err != nil {
+		return err
+	}
+	if out == nil || len(body) == 0 {
+		return nil
+	}
+	return json.Unmarshal(body, out)
+}
+
+func (c *Client) getRaw(endpoint []string, accept string) ([]byte, error) {
+	return c.rawRequest("GET", endpoint, nil, "", nil, accept)
+}
+
+// postForm submits values as a form-encoded POST body (rather than a query
+// string) and unmarshals the XML response into out, for endpoints that read
+// their parameters from the request body.
+func (c *Client) postForm(endpoint []string, values url.Values, out interface{}) error {
+	var body []byte
+	if len(values) > 0 {
+		body = []byte(values.Encode())
+	}
+
+	respBody, err := c.rawRequest("POST", endpoint, nil, contentTypeForm, body, "")
+	if err != nil {
+		return err
+	}
+	if out == nil || len(respBody) == 0 {
+		return nil
+	}
+	return xml.Unmarshal(respBody, out)
+}